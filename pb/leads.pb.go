@@ -7,6 +7,7 @@
 package pb
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
@@ -212,6 +213,7 @@ func (x *GetProductRequest) GetId() string {
 	return ""
 }
 
+// Empty fields are left unchanged
 type UpdateProductRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -281,8 +283,10 @@ func (x *UpdateProductRequest) GetSchema() *structpb.Struct {
 }
 
 type DeleteProductRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// also delete the product's leads; without it a product with leads is kept
+	Cascade       bool `protobuf:"varint,2,opt,name=cascade,proto3" json:"cascade,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -324,6 +328,13 @@ func (x *DeleteProductRequest) GetId() string {
 	return ""
 }
 
+func (x *DeleteProductRequest) GetCascade() bool {
+	if x != nil {
+		return x.Cascade
+	}
+	return false
+}
+
 type ListProductsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
@@ -548,6 +559,7 @@ type LeadResponse struct {
 	Objects       []*LeadObject          `protobuf:"bytes,3,rep,name=objects,proto3" json:"objects,omitempty"`
 	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     string                 `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Version       int32                  `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -617,6 +629,13 @@ func (x *LeadResponse) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *LeadResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type GetLeadRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -662,9 +681,11 @@ func (x *GetLeadRequest) GetId() string {
 }
 
 type UpdateLeadRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Objects       []*LeadObject          `protobuf:"bytes,2,rep,name=objects,proto3" json:"objects,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Objects []*LeadObject          `protobuf:"bytes,2,rep,name=objects,proto3" json:"objects,omitempty"`
+	// the lead version the client last read; required
+	Version       int32 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -713,6 +734,13 @@ func (x *UpdateLeadRequest) GetObjects() []*LeadObject {
 	return nil
 }
 
+func (x *UpdateLeadRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type DeleteLeadRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -874,7 +902,7 @@ var File_leads_proto protoreflect.FileDescriptor
 
 const file_leads_proto_rawDesc = "" +
 	"\n" +
-	"\vleads.proto\x12\x05leads\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1bgoogle/protobuf/empty.proto\"}\n" +
+	"\vleads.proto\x12\x05leads\x1a\x1cgoogle/api/annotations.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1bgoogle/protobuf/empty.proto\"}\n" +
 	"\x14CreateProductRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12/\n" +
@@ -894,9 +922,10 @@ const file_leads_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12/\n" +
-	"\x06schema\x18\x04 \x01(\v2\x17.google.protobuf.StructR\x06schema\"&\n" +
+	"\x06schema\x18\x04 \x01(\v2\x17.google.protobuf.StructR\x06schema\"@\n" +
 	"\x14DeleteProductRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"C\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\acascade\x18\x02 \x01(\bR\acascade\"C\n" +
 	"\x13ListProductsRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x02 \x01(\x05R\x06offset\"`\n" +
@@ -912,7 +941,7 @@ const file_leads_proto_rawDesc = "" +
 	"\fphone_number\x18\x01 \x01(\tR\vphoneNumber\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x02 \x01(\tR\tproductId\x12+\n" +
-	"\x04data\x18\x03 \x01(\v2\x17.google.protobuf.StructR\x04data\"\xac\x01\n" +
+	"\x04data\x18\x03 \x01(\v2\x17.google.protobuf.StructR\x04data\"\xc6\x01\n" +
 	"\fLeadResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
 	"\fphone_number\x18\x02 \x01(\tR\vphoneNumber\x12+\n" +
@@ -920,12 +949,14 @@ const file_leads_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x05 \x01(\tR\tupdatedAt\" \n" +
+	"updated_at\x18\x05 \x01(\tR\tupdatedAt\x12\x18\n" +
+	"\aversion\x18\x06 \x01(\x05R\aversion\" \n" +
 	"\x0eGetLeadRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"P\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"j\n" +
 	"\x11UpdateLeadRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12+\n" +
-	"\aobjects\x18\x02 \x03(\v2\x11.leads.LeadObjectR\aobjects\"#\n" +
+	"\aobjects\x18\x02 \x03(\v2\x11.leads.LeadObjectR\aobjects\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x05R\aversion\"#\n" +
 	"\x11DeleteLeadRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"_\n" +
 	"\x10ListLeadsRequest\x12\x1d\n" +
@@ -935,22 +966,24 @@ const file_leads_proto_rawDesc = "" +
 	"\x06offset\x18\x03 \x01(\x05R\x06offset\"T\n" +
 	"\x11ListLeadsResponse\x12)\n" +
 	"\x05leads\x18\x01 \x03(\v2\x13.leads.LeadResponseR\x05leads\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total2\x9c\x05\n" +
-	"\x0eProductService\x12D\n" +
-	"\rCreateProduct\x12\x1b.leads.CreateProductRequest\x1a\x16.leads.ProductResponse\x12>\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total2\xb6\a\n" +
+	"\x0eProductService\x12^\n" +
+	"\rCreateProduct\x12\x1b.leads.CreateProductRequest\x1a\x16.leads.ProductResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/api/products\x12Z\n" +
 	"\n" +
-	"GetProduct\x12\x18.leads.GetProductRequest\x1a\x16.leads.ProductResponse\x12D\n" +
-	"\rUpdateProduct\x12\x1b.leads.UpdateProductRequest\x1a\x16.leads.ProductResponse\x12D\n" +
-	"\rDeleteProduct\x12\x1b.leads.DeleteProductRequest\x1a\x16.google.protobuf.Empty\x12G\n" +
-	"\fListProducts\x12\x1a.leads.ListProductsRequest\x1a\x1b.leads.ListProductsResponse\x12;\n" +
+	"GetProduct\x12\x18.leads.GetProductRequest\x1a\x16.leads.ProductResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/products/{id}\x12|\n" +
+	"\rUpdateProduct\x12\x1b.leads.UpdateProductRequest\x1a\x16.leads.ProductResponse\"6\x82\xd3\xe4\x93\x020:\x01*Z\x17:\x01*2\x12/api/products/{id}\x1a\x12/api/products/{id}\x12`\n" +
+	"\rDeleteProduct\x12\x1b.leads.DeleteProductRequest\x1a\x16.google.protobuf.Empty\"\x1a\x82\xd3\xe4\x93\x02\x14*\x12/api/products/{id}\x12^\n" +
+	"\fListProducts\x12\x1a.leads.ListProductsRequest\x1a\x1b.leads.ListProductsResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/products\x12R\n" +
 	"\n" +
-	"CreateLead\x12\x18.leads.CreateLeadRequest\x1a\x13.leads.LeadResponse\x125\n" +
-	"\aGetLead\x12\x15.leads.GetLeadRequest\x1a\x13.leads.LeadResponse\x12;\n" +
+	"CreateLead\x12\x18.leads.CreateLeadRequest\x1a\x13.leads.LeadResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/api/leads\x12N\n" +
+	"\aGetLead\x12\x15.leads.GetLeadRequest\x1a\x13.leads.LeadResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/api/leads/{id}\x12W\n" +
 	"\n" +
-	"UpdateLead\x12\x18.leads.UpdateLeadRequest\x1a\x13.leads.LeadResponse\x12>\n" +
+	"UpdateLead\x12\x18.leads.UpdateLeadRequest\x1a\x13.leads.LeadResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\x1a\x0f/api/leads/{id}\x12W\n" +
 	"\n" +
-	"DeleteLead\x12\x18.leads.DeleteLeadRequest\x1a\x16.google.protobuf.Empty\x12>\n" +
-	"\tListLeads\x12\x17.leads.ListLeadsRequest\x1a\x18.leads.ListLeadsResponseB\x06Z\x04./pbb\x06proto3"
+	"DeleteLead\x12\x18.leads.DeleteLeadRequest\x1a\x16.google.protobuf.Empty\"\x17\x82\xd3\xe4\x93\x02\x11*\x0f/api/leads/{id}\x12R\n" +
+	"\tListLeads\x12\x17.leads.ListLeadsRequest\x1a\x18.leads.ListLeadsResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/api/leadsB\x06Z\x04./pbb\x06proto3"
 
 var (
 	file_leads_proto_rawDescOnce sync.Once